@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rsvagle/GoWeatherApp/cache"
+)
+
+// WeatherBackend fetches forecast data from a specific provider and
+// normalizes it into the app's Weather/DailyWeather structs so the
+// renderer doesn't need to know which provider answered.
+type WeatherBackend interface {
+	// Fetch retrieves a numDays forecast for the given coordinates in
+	// the requested units.
+	Fetch(lat string, lon string, numDays int, units Units) (Weather, error)
+
+	// Configure applies backend-specific settings (e.g. API keys) read
+	// from the environment or the ~/.goweatherrc config file. It is
+	// called once before the first Fetch.
+	Configure(cfg map[string]string) error
+}
+
+// defaultBackend is used when no --backend flag or config entry is set.
+const defaultBackend = "open-meteo"
+
+// backendRegistry holds every WeatherBackend available to the app, keyed
+// by the name used on the --backend flag and in ~/.goweatherrc.
+var backendRegistry = map[string]WeatherBackend{}
+
+// RegisterBackend makes a WeatherBackend available for selection by name.
+// Backends register themselves from an init() function in their own file.
+func RegisterBackend(name string, b WeatherBackend) {
+	backendRegistry[name] = b
+}
+
+// getBackend looks up a registered WeatherBackend by name.
+func getBackend(name string) (WeatherBackend, error) {
+	b, ok := backendRegistry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown weather backend %q", name)
+	}
+	return b, nil
+}
+
+// CachingBackend wraps another WeatherBackend and caches its Fetch
+// results on disk via the cache package, so repeat launches don't
+// hammer the underlying provider.
+type CachingBackend struct {
+	backend WeatherBackend
+	ttl     time.Duration
+}
+
+// newCachingBackend wraps backend, caching its Fetch results for ttl.
+func newCachingBackend(backend WeatherBackend, ttl time.Duration) *CachingBackend {
+	return &CachingBackend{backend: backend, ttl: ttl}
+}
+
+// Configure delegates to the wrapped backend.
+func (c *CachingBackend) Configure(cfg map[string]string) error {
+	return c.backend.Configure(cfg)
+}
+
+// Fetch serves a cached response when one is fresh enough, otherwise
+// falls through to the wrapped backend and caches the result.
+func (c *CachingBackend) Fetch(lat string, lon string, numDays int, units Units) (Weather, error) {
+	key := fmt.Sprintf("weather:%T:%s:%s:%d:%s", c.backend, lat, lon, numDays, units.Name)
+
+	if body, ok := cache.Get(key, c.ttl); ok {
+		var weather Weather
+		if err := json.Unmarshal(body, &weather); err == nil {
+			return weather, nil
+		}
+	}
+
+	weather, err := c.backend.Fetch(lat, lon, numDays, units)
+	if err != nil {
+		return weather, err
+	}
+
+	if body, err := json.Marshal(weather); err == nil {
+		_ = cache.Set(key, body)
+	}
+
+	return weather, nil
+}
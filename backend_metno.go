@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// MetNoBackend fetches forecasts from the Norwegian Meteorological
+// Institute's free locationforecast API. It needs no API key, but met.no
+// requires every request to carry an identifying User-Agent.
+type MetNoBackend struct{}
+
+func init() {
+	RegisterBackend("met.no", &MetNoBackend{})
+}
+
+// Configure is a no-op for met.no since it requires no credentials.
+func (b *MetNoBackend) Configure(cfg map[string]string) error {
+	return nil
+}
+
+// API response shape for met.no's locationforecast/2.0/compact endpoint
+type metNoResponse struct {
+	Properties struct {
+		Timeseries []struct {
+			Time string `json:"time"`
+			Data struct {
+				Instant struct {
+					Details struct {
+						AirTemperature float64 `json:"air_temperature"`
+					} `json:"details"`
+				} `json:"instant"`
+				Next6Hours struct {
+					Summary struct {
+						SymbolCode string `json:"symbol_code"`
+					} `json:"summary"`
+				} `json:"next_6_hours"`
+			} `json:"data"`
+		} `json:"timeseries"`
+	} `json:"properties"`
+}
+
+func (b *MetNoBackend) Fetch(lat string, lon string, numDays int, units Units) (Weather, error) {
+	url := fmt.Sprintf("https://api.met.no/weatherapi/locationforecast/2.0/compact?lat=%s&lon=%s", lat, lon)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return Weather{}, fmt.Errorf("met.no: %w", err)
+	}
+	req.Header.Set("User-Agent", "GoWeatherApp/1.0 github.com/rsvagle/GoWeatherApp")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Weather{}, fmt.Errorf("met.no: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Weather{}, fmt.Errorf("met.no: %w", err)
+	}
+
+	var resp metNoResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Weather{}, fmt.Errorf("met.no: %w", err)
+	}
+
+	// met.no returns hourly timesteps with no daily summary, so bucket
+	// them into days ourselves and track the min/max temperature seen.
+	type dayBucket struct {
+		max    float64
+		min    float64
+		symbol string
+	}
+	buckets := map[string]*dayBucket{}
+	var days []string
+
+	for _, entry := range resp.Properties.Timeseries {
+		if len(entry.Time) < 10 {
+			continue
+		}
+		day := entry.Time[:10]
+		temp := entry.Data.Instant.Details.AirTemperature
+		if units.Temperature == "fahrenheit" {
+			temp = temp*9/5 + 32
+		}
+
+		bucket, ok := buckets[day]
+		if !ok {
+			bucket = &dayBucket{max: temp, min: temp}
+			buckets[day] = bucket
+			days = append(days, day)
+		}
+		if temp > bucket.max {
+			bucket.max = temp
+		}
+		if temp < bucket.min {
+			bucket.min = temp
+		}
+		if symbol := entry.Data.Next6Hours.Summary.SymbolCode; symbol != "" {
+			bucket.symbol = symbol
+		}
+	}
+
+	sort.Strings(days)
+	if len(days) > numDays {
+		days = days[:numDays]
+	}
+
+	var weather Weather
+	for _, day := range days {
+		bucket := buckets[day]
+		weather.Daily.Time = append(weather.Daily.Time, day)
+		weather.Daily.WeatherCode = append(weather.Daily.WeatherCode, mapMetNoSymbolToWMO(bucket.symbol))
+		weather.Daily.TemperatureMax = append(weather.Daily.TemperatureMax, bucket.max)
+		weather.Daily.TemperatureMin = append(weather.Daily.TemperatureMin, bucket.min)
+	}
+
+	return weather, nil
+}
+
+// mapMetNoSymbolToWMO translates a met.no symbol_code
+// (https://api.met.no/weatherapi/weathericon/2.0/documentation) into the
+// nearest WMO weather code the ASCII renderer understands.
+func mapMetNoSymbolToWMO(symbol string) int {
+	base := strings.SplitN(symbol, "_", 2)[0]
+	switch base {
+	case "clearsky", "fair":
+		return 0
+	case "partlycloudy", "cloudy":
+		return 2
+	case "fog":
+		return 45
+	case "rain", "lightrain", "heavyrain", "rainshowers", "lightrainshowers", "heavyrainshowers":
+		return 63
+	case "snow", "lightsnow", "heavysnow", "snowshowers", "sleet":
+		return 73
+	case "rainandthunder", "heavyrainandthunder", "thunder":
+		return 95
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// OpenMeteoBackend fetches forecasts from the free Open-Meteo API. It
+// needs no API key and is used by default.
+type OpenMeteoBackend struct{}
+
+func init() {
+	RegisterBackend("open-meteo", &OpenMeteoBackend{})
+}
+
+// Configure is a no-op for Open-Meteo since it requires no credentials.
+func (b *OpenMeteoBackend) Configure(cfg map[string]string) error {
+	return nil
+}
+
+// Fetch requests a numDays forecast for lat/lon from Open-Meteo. The
+// response already matches the Weather struct, so it's decoded directly.
+func (b *OpenMeteoBackend) Fetch(lat string, lon string, numDays int, units Units) (Weather, error) {
+	dailyMetrics := "weather_code,temperature_2m_max,temperature_2m_min"
+	hourlyMetrics := "temperature_2m,precipitation_probability,weather_code"
+
+	baseURL := "https://api.open-meteo.com/v1/forecast"
+
+	// build the query string
+	queryString := fmt.Sprintf("?latitude=%s&longitude=%s&daily=%s&hourly=%s&temperature_unit=%s&wind_speed_unit=%s&precipitation_unit=%s&timezone=auto&forecast_days=%d",
+		lat,
+		lon,
+		dailyMetrics,
+		hourlyMetrics,
+		units.Temperature,
+		units.WindSpeed,
+		units.Precipitation,
+		numDays,
+	)
+
+	// construct the full string
+	fullURL := baseURL + queryString
+
+	// make the http request for weather data
+	res, err := http.Get(fullURL)
+	if err != nil {
+		return Weather{}, fmt.Errorf("open-meteo: %w", err)
+	}
+	defer res.Body.Close()
+
+	// read the body in
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Weather{}, fmt.Errorf("open-meteo: %w", err)
+	}
+
+	// put the json response into my object
+	var weather Weather
+	if err := json.Unmarshal(body, &weather); err != nil {
+		return Weather{}, fmt.Errorf("open-meteo: %w", err)
+	}
+
+	return weather, nil
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+)
+
+// OpenWeatherMapBackend fetches forecasts from OpenWeatherMap's free
+// 5 day / 3 hour forecast endpoint and buckets the 3-hour samples into
+// daily highs/lows/codes to match the app's Weather struct.
+type OpenWeatherMapBackend struct {
+	apiKey string
+}
+
+func init() {
+	RegisterBackend("openweathermap", &OpenWeatherMapBackend{})
+}
+
+// Configure reads the API key from the config file's "owm_api_key" entry
+// or, failing that, the OWM_API_KEY environment variable.
+func (b *OpenWeatherMapBackend) Configure(cfg map[string]string) error {
+	if key := cfg["owm_api_key"]; key != "" {
+		b.apiKey = key
+		return nil
+	}
+
+	b.apiKey = os.Getenv("OWM_API_KEY")
+	if b.apiKey == "" {
+		return fmt.Errorf("openweathermap: missing API key (set OWM_API_KEY or owm_api_key in ~/.goweatherrc)")
+	}
+	return nil
+}
+
+// API response shape for OpenWeatherMap's forecast endpoint
+type owmForecastResponse struct {
+	List []struct {
+		Dt   int64 `json:"dt"`
+		Main struct {
+			TempMax float64 `json:"temp_max"`
+			TempMin float64 `json:"temp_min"`
+		} `json:"main"`
+		Weather []struct {
+			ID int `json:"id"`
+		} `json:"weather"`
+	} `json:"list"`
+}
+
+func (b *OpenWeatherMapBackend) Fetch(lat string, lon string, numDays int, units Units) (Weather, error) {
+	if b.apiKey == "" {
+		return Weather{}, fmt.Errorf("openweathermap: not configured, call Configure first")
+	}
+
+	// Only temperatures are read from the response, so OpenWeatherMap's
+	// Celsius "metric" setting covers both our metric and si unit systems.
+	owmUnits := "imperial"
+	if units.Temperature == "celsius" {
+		owmUnits = "metric"
+	}
+
+	url := fmt.Sprintf("https://api.openweathermap.org/data/2.5/forecast?lat=%s&lon=%s&units=%s&appid=%s",
+		lat, lon, owmUnits, b.apiKey)
+
+	res, err := http.Get(url)
+	if err != nil {
+		return Weather{}, fmt.Errorf("openweathermap: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Weather{}, fmt.Errorf("openweathermap: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return Weather{}, fmt.Errorf("openweathermap: request failed with status %d: %s", res.StatusCode, body)
+	}
+
+	var resp owmForecastResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Weather{}, fmt.Errorf("openweathermap: %w", err)
+	}
+
+	if len(resp.List) == 0 {
+		return Weather{}, fmt.Errorf("openweathermap: no forecast data in response")
+	}
+
+	// OpenWeatherMap's free tier only gives 3-hour samples, so bucket
+	// them into days and track the min/max seen for each one.
+	type dayBucket struct {
+		max  float64
+		min  float64
+		code int
+	}
+	buckets := map[string]*dayBucket{}
+	var days []string
+
+	for _, entry := range resp.List {
+		day := time.Unix(entry.Dt, 0).UTC().Format("2006-01-02")
+		bucket, ok := buckets[day]
+		if !ok {
+			bucket = &dayBucket{max: entry.Main.TempMax, min: entry.Main.TempMin}
+			buckets[day] = bucket
+			days = append(days, day)
+		}
+		if entry.Main.TempMax > bucket.max {
+			bucket.max = entry.Main.TempMax
+		}
+		if entry.Main.TempMin < bucket.min {
+			bucket.min = entry.Main.TempMin
+		}
+		if len(entry.Weather) > 0 {
+			bucket.code = mapOWMCodeToWMO(entry.Weather[0].ID)
+		}
+	}
+
+	sort.Strings(days)
+	if len(days) > numDays {
+		days = days[:numDays]
+	}
+
+	var weather Weather
+	for _, day := range days {
+		bucket := buckets[day]
+		weather.Daily.Time = append(weather.Daily.Time, day)
+		weather.Daily.WeatherCode = append(weather.Daily.WeatherCode, bucket.code)
+		weather.Daily.TemperatureMax = append(weather.Daily.TemperatureMax, bucket.max)
+		weather.Daily.TemperatureMin = append(weather.Daily.TemperatureMin, bucket.min)
+	}
+
+	return weather, nil
+}
+
+// mapOWMCodeToWMO translates an OpenWeatherMap condition code
+// (https://openweathermap.org/weather-conditions) into the nearest WMO
+// weather code the ASCII renderer understands.
+func mapOWMCodeToWMO(id int) int {
+	switch {
+	case id == 800:
+		return 0
+	case id > 800 && id < 900:
+		return 2
+	case id >= 200 && id < 300:
+		return 95
+	case id >= 300 && id < 400:
+		return 51
+	case id >= 500 && id < 600:
+		return 63
+	case id >= 600 && id < 700:
+		return 73
+	case id >= 700 && id < 800:
+		return 45
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// WorldWeatherOnlineBackend fetches forecasts from the WorldWeatherOnline
+// premium API, which already reports per-day highs/lows. Each day's
+// condition code is taken from its first hourly entry.
+type WorldWeatherOnlineBackend struct {
+	apiKey string
+}
+
+func init() {
+	RegisterBackend("worldweatheronline", &WorldWeatherOnlineBackend{})
+}
+
+// Configure reads the API key from the config file's "wwo_api_key" entry
+// or, failing that, the WWO_API_KEY environment variable.
+func (b *WorldWeatherOnlineBackend) Configure(cfg map[string]string) error {
+	if key := cfg["wwo_api_key"]; key != "" {
+		b.apiKey = key
+		return nil
+	}
+
+	b.apiKey = os.Getenv("WWO_API_KEY")
+	if b.apiKey == "" {
+		return fmt.Errorf("worldweatheronline: missing API key (set WWO_API_KEY or wwo_api_key in ~/.goweatherrc)")
+	}
+	return nil
+}
+
+// API response shape for WorldWeatherOnline's weather.ashx endpoint
+type wwoResponse struct {
+	Data struct {
+		Weather []struct {
+			Date     string `json:"date"`
+			MaxTempF string `json:"maxtempF"`
+			MinTempF string `json:"mintempF"`
+			MaxTempC string `json:"maxtempC"`
+			MinTempC string `json:"mintempC"`
+			Hourly   []struct {
+				WeatherCode string `json:"weatherCode"`
+			} `json:"hourly"`
+		} `json:"weather"`
+	} `json:"data"`
+}
+
+func (b *WorldWeatherOnlineBackend) Fetch(lat string, lon string, numDays int, units Units) (Weather, error) {
+	if b.apiKey == "" {
+		return Weather{}, fmt.Errorf("worldweatheronline: not configured, call Configure first")
+	}
+
+	url := fmt.Sprintf("https://api.worldweatheronline.com/premium/v1/weather.ashx?key=%s&q=%s,%s&format=json&num_of_days=%d",
+		b.apiKey, lat, lon, numDays)
+
+	res, err := http.Get(url)
+	if err != nil {
+		return Weather{}, fmt.Errorf("worldweatheronline: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Weather{}, fmt.Errorf("worldweatheronline: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return Weather{}, fmt.Errorf("worldweatheronline: request failed with status %d: %s", res.StatusCode, body)
+	}
+
+	var resp wwoResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return Weather{}, fmt.Errorf("worldweatheronline: %w", err)
+	}
+
+	if len(resp.Data.Weather) == 0 {
+		return Weather{}, fmt.Errorf("worldweatheronline: no forecast data in response")
+	}
+
+	var weather Weather
+	for _, day := range resp.Data.Weather {
+		maxTempStr, minTempStr := day.MaxTempF, day.MinTempF
+		if units.Temperature == "celsius" {
+			maxTempStr, minTempStr = day.MaxTempC, day.MinTempC
+		}
+		maxTemp, _ := strconv.ParseFloat(maxTempStr, 64)
+		minTemp, _ := strconv.ParseFloat(minTempStr, 64)
+
+		code := 1
+		if len(day.Hourly) > 0 {
+			wwoCode, _ := strconv.Atoi(day.Hourly[0].WeatherCode)
+			code = mapWWOCodeToWMO(wwoCode)
+		}
+
+		weather.Daily.Time = append(weather.Daily.Time, day.Date)
+		weather.Daily.WeatherCode = append(weather.Daily.WeatherCode, code)
+		weather.Daily.TemperatureMax = append(weather.Daily.TemperatureMax, maxTemp)
+		weather.Daily.TemperatureMin = append(weather.Daily.TemperatureMin, minTemp)
+	}
+
+	return weather, nil
+}
+
+// mapWWOCodeToWMO translates a WorldWeatherOnline condition code
+// (https://www.worldweatheronline.com/weather-api/api/docs/weather-icons.aspx)
+// into the nearest WMO weather code the ASCII renderer understands.
+func mapWWOCodeToWMO(code int) int {
+	switch code {
+	case 113:
+		return 0
+	case 116, 119, 122:
+		return 2
+	case 143, 248, 260:
+		return 45
+	case 176, 179, 182, 185, 263, 266, 281, 284, 293, 296, 299, 302, 305, 308, 311, 314, 317, 320, 353, 356, 359, 362, 365, 392:
+		return 63
+	case 227, 230, 323, 326, 329, 332, 335, 338, 350, 368, 371, 374, 377, 395:
+		return 73
+	case 200, 386, 389:
+		return 95
+	default:
+		return 1
+	}
+}
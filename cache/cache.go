@@ -0,0 +1,92 @@
+// Package cache provides a small on-disk cache for JSON API responses,
+// keyed by a sha256 hash of a caller-supplied key (typically the request
+// URL) so repeat launches don't hammer the weather/geocoding providers.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Default TTLs for the two kinds of responses this app caches.
+const (
+	WeatherTTL   = 30 * time.Minute
+	GeocodingTTL = 30 * 24 * time.Hour
+)
+
+// entry is the on-disk envelope stored for each cached response.
+type entry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Body     json.RawMessage `json:"body"`
+}
+
+// dir returns $XDG_CACHE_HOME/goweather, falling back to ~/.cache/goweather.
+func dir() (string, error) {
+	base := os.Getenv("XDG_CACHE_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("cache: %w", err)
+		}
+		base = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(base, "goweather"), nil
+}
+
+// pathFor maps a cache key to the file it's stored under.
+func pathFor(key string) (string, error) {
+	d, err := dir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+// Get returns the cached body for key if present and younger than ttl.
+func Get(key string, ttl time.Duration) ([]byte, bool) {
+	path, err := pathFor(key)
+	if err != nil {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, false
+	}
+
+	if time.Since(e.StoredAt) > ttl {
+		return nil, false
+	}
+
+	return e.Body, true
+}
+
+// Set stores body under key for later retrieval by Get.
+func Set(key string, body []byte) error {
+	path, err := pathFor(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("cache: %w", err)
+	}
+
+	data, err := json.Marshal(entry{StoredAt: time.Now(), Body: body})
+	if err != nil {
+		return fmt.Errorf("cache: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
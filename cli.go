@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rsvagle/GoWeatherApp/render"
+)
+
+// runNonInteractive fetches the current location's forecast and prints it
+// in format, one of "oneline", "json", or "ansi", instead of launching the
+// Bubble Tea program. It's what lets the binary be used like wttr.in in a
+// script or status bar.
+func runNonInteractive(format string, backend WeatherBackend, units Units, lang string) {
+	location := getLocationData()
+
+	weather, err := backend.Fetch(location.Lat, location.Lon, forecastDays, units)
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+
+	switch format {
+	case "oneline":
+		fmt.Println(formatOneline(location, weather, units))
+	case "json":
+		if err := json.NewEncoder(os.Stdout).Encode(struct {
+			Location Location `json:"location"`
+			Weather  Weather  `json:"weather"`
+		}{location, weather}); err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(1)
+		}
+	case "ansi":
+		fmt.Print(render.Grid(weather, location, lang))
+	default:
+		fmt.Println("Error: unknown format", format)
+		os.Exit(1)
+	}
+}
+
+// onelineIcons maps a WMO weather code to the emoji formatOneline shows.
+var onelineIcons = map[int]string{
+	0:  "☀️",
+	1:  "🌤️",
+	2:  "⛅",
+	3:  "☁️",
+	45: "🌫️", 48: "🌫️",
+	51: "🌦️", 53: "🌦️", 55: "🌦️", 56: "🌦️", 57: "🌦️",
+	61: "🌧️", 63: "🌧️", 65: "🌧️", 66: "🌧️", 67: "🌧️", 80: "🌧️", 81: "🌧️", 82: "🌧️",
+	71: "❄️", 73: "❄️", 75: "❄️", 77: "❄️", 85: "❄️", 86: "❄️",
+	95: "⛈️", 96: "⛈️", 99: "⛈️",
+}
+
+// formatOneline renders today's forecast as a single line suitable for a
+// status bar, e.g. "SF: ☀️ +68°F↓+55°F".
+func formatOneline(loc Location, w Weather, units Units) string {
+	icon := onelineIcons[0]
+	if len(w.Daily.WeatherCode) > 0 {
+		if found, ok := onelineIcons[w.Daily.WeatherCode[0]]; ok {
+			icon = found
+		}
+	}
+
+	var high, low float64
+	if len(w.Daily.TemperatureMax) > 0 {
+		high = w.Daily.TemperatureMax[0]
+	}
+	if len(w.Daily.TemperatureMin) > 0 {
+		low = w.Daily.TemperatureMin[0]
+	}
+
+	suffix := "F"
+	if units.Temperature == "celsius" {
+		suffix = "C"
+	}
+
+	return fmt.Sprintf("%s: %s %+.0f°%s↓%+.0f°%s", loc.City, icon, high, suffix, low, suffix)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// loadConfig reads ~/.goweatherrc and returns its contents as a map. The
+// format is INI-ish but not actual INI or TOML: it's a flat "key = value"
+// scanner (one setting per line; a line starting with "#" or ";" is a
+// comment, and a comment cannot follow a value on the same line) that
+// recognizes "[section]" lines only well enough to skip them — it does
+// not scope keys to their section, so a key with the same name in two
+// sections collides. No backend needs section-scoped keys today. A
+// missing file is not an error; it just yields an empty config.
+func loadConfig() (map[string]string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	path := filepath.Join(home, ".goweatherrc")
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+	defer file.Close()
+
+	cfg := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "[") {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.Trim(strings.TrimSpace(parts[1]), `"`)
+		cfg[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/rsvagle/GoWeatherApp/cache"
+)
+
+// PlaceCandidate is one match from a free-text place search, ready to
+// display in the selection view or fetch weather for.
+type PlaceCandidate struct {
+	Name      string
+	Admin1    string
+	Country   string
+	Latitude  float64
+	Longitude float64
+}
+
+// countryAbbreviations maps the handful of ISO country codes users are
+// most likely to recognize abbreviated to their full display name,
+// keyed by geocodingResponse's country_code.
+var countryAbbreviations = map[string]string{
+	"US": "United States",
+	"UK": "United Kingdom",
+}
+
+// API response shape for Open-Meteo's geocoding search endpoint. Country is
+// already the expanded name (e.g. "United States"); CountryCode is the
+// two-letter code countryAbbreviations keys off of.
+type geocodingResponse struct {
+	Results []struct {
+		Name        string  `json:"name"`
+		Admin1      string  `json:"admin1"`
+		Country     string  `json:"country"`
+		CountryCode string  `json:"country_code"`
+		Latitude    float64 `json:"latitude"`
+		Longitude   float64 `json:"longitude"`
+	} `json:"results"`
+}
+
+// searchPlaces queries Open-Meteo's geocoding API for places matching a
+// free-text query like "Paris" or "Springfield".
+func searchPlaces(query string) ([]PlaceCandidate, error) {
+	requestURL := fmt.Sprintf("https://geocoding-api.open-meteo.com/v1/search?name=%s&count=10", url.QueryEscape(query))
+
+	var body []byte
+	if cacheEnabled {
+		if cached, ok := cache.Get(requestURL, cache.GeocodingTTL); ok {
+			body = cached
+		}
+	}
+
+	if body == nil {
+		res, err := http.Get(requestURL)
+		if err != nil {
+			return nil, fmt.Errorf("geocode: %w", err)
+		}
+		defer res.Body.Close()
+
+		respBody, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, fmt.Errorf("geocode: %w", err)
+		}
+		body = respBody
+
+		if cacheEnabled {
+			if err := cache.Set(requestURL, body); err != nil {
+				fmt.Println("Error:", err)
+			}
+		}
+	}
+
+	var resp geocodingResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("geocode: %w", err)
+	}
+
+	candidates := make([]PlaceCandidate, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		country := r.Country
+		if expanded, ok := countryAbbreviations[r.CountryCode]; ok {
+			country = expanded
+		}
+		candidates = append(candidates, PlaceCandidate{
+			Name:      r.Name,
+			Admin1:    r.Admin1,
+			Country:   country,
+			Latitude:  r.Latitude,
+			Longitude: r.Longitude,
+		})
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,121 @@
+// Package i18n translates weather condition codes and the handful of UI
+// strings into the app's supported languages, shared by both the TUI and
+// the render package so a CLI run stays localized the same way.
+package i18n
+
+// DefaultLang is used when no --lang flag or config entry is set, and as
+// the fallback for any string missing from a more specific language.
+const DefaultLang = "en"
+
+// weatherCodeGroups buckets the WMO weather codes the ASCII renderer
+// understands into the same categories the render package's ASCII icons
+// switch on, so each
+// language only has to name the categories once.
+var weatherCodeGroups = map[string][]int{
+	"sunny":        {0},
+	"cloudy":       {1, 2, 3},
+	"fog":          {45, 48},
+	"rain":         {51, 53, 55, 56, 57, 61, 63, 65, 66, 67, 80, 81, 82},
+	"snow":         {71, 73, 75, 77, 85, 86},
+	"thunderstorm": {95, 96, 99},
+}
+
+// weatherCategoryWords names each weatherCodeGroups category per ISO
+// language code.
+var weatherCategoryWords = map[string]map[string]string{
+	"en": {
+		"sunny": "Sunny", "cloudy": "Cloudy", "fog": "Fog",
+		"rain": "Rain", "snow": "Snow", "thunderstorm": "Thunderstorm",
+	},
+	"de": {
+		"sunny": "Sonnig", "cloudy": "Bewölkt", "fog": "Nebel",
+		"rain": "Regen", "snow": "Schnee", "thunderstorm": "Gewitter",
+	},
+	"es": {
+		"sunny": "Soleado", "cloudy": "Nublado", "fog": "Niebla",
+		"rain": "Lluvia", "snow": "Nieve", "thunderstorm": "Tormenta",
+	},
+	"fr": {
+		"sunny": "Ensoleillé", "cloudy": "Nuageux", "fog": "Brouillard",
+		"rain": "Pluie", "snow": "Neige", "thunderstorm": "Orage",
+	},
+}
+
+// weatherDescriptions maps ISO language code -> WMO weather code ->
+// localized condition name. It's built once from weatherCodeGroups and
+// weatherCategoryWords so adding a language only requires naming each
+// category, not every individual code.
+var weatherDescriptions = buildWeatherDescriptions()
+
+func buildWeatherDescriptions() map[string]map[int]string {
+	out := make(map[string]map[int]string, len(weatherCategoryWords))
+	for lang, words := range weatherCategoryWords {
+		codes := make(map[int]string)
+		for category, list := range weatherCodeGroups {
+			for _, code := range list {
+				codes[code] = words[category]
+			}
+		}
+		out[lang] = codes
+	}
+	return out
+}
+
+// Description translates a WMO weather code into a condition name in
+// lang, falling back to English for an unknown language or code.
+func Description(code int, lang string) string {
+	if desc, ok := weatherDescriptions[lang][code]; ok {
+		return desc
+	}
+	if desc, ok := weatherDescriptions[DefaultLang][code]; ok {
+		return desc
+	}
+	return "Unknown weather code"
+}
+
+// uiStrings maps ISO language code -> UI string key -> localized text
+// for the handful of labels and prompts rendered outside the weather
+// condition names.
+var uiStrings = map[string]map[string]string{
+	"en": {
+		"high":            "High",
+		"low":             "Low",
+		"prompt":          "Enter a place name (e.g., Paris) to get weather, press 'h' for the hourly forecast, 'u' to cycle units, or type 'quit' to exit: ",
+		"next24Hours":     "Next 24 Hours",
+		"multipleMatches": "Multiple places matched — pick one:",
+		"candidatePrompt": "Press a number to select a place, or backspace to cancel",
+	},
+	"de": {
+		"high":            "Hoch",
+		"low":             "Tief",
+		"prompt":          "Gib einen Ortsnamen ein (z. B. Paris), um das Wetter abzurufen, drücke 'h' für die Stundenvorhersage, 'u' zum Wechseln der Einheiten, oder gib 'quit' ein, um zu beenden: ",
+		"next24Hours":     "Nächste 24 Stunden",
+		"multipleMatches": "Mehrere Orte gefunden — bitte auswählen:",
+		"candidatePrompt": "Drücke eine Zahl, um einen Ort auszuwählen, oder Rücktaste zum Abbrechen",
+	},
+	"es": {
+		"high":            "Máx",
+		"low":             "Mín",
+		"prompt":          "Introduce el nombre de un lugar (p. ej., Paris) para obtener el clima, pulsa 'h' para el pronóstico por horas, 'u' para cambiar las unidades, o escribe 'quit' para salir: ",
+		"next24Hours":     "Próximas 24 horas",
+		"multipleMatches": "Varios lugares coinciden — elige uno:",
+		"candidatePrompt": "Pulsa un número para elegir un lugar, o retroceso para cancelar",
+	},
+	"fr": {
+		"high":            "Max",
+		"low":             "Min",
+		"prompt":          "Entrez le nom d'un lieu (ex. Paris) pour obtenir la météo, appuyez sur 'h' pour les prévisions horaires, 'u' pour changer les unités, ou tapez 'quit' pour quitter: ",
+		"next24Hours":     "Prochaines 24 heures",
+		"multipleMatches": "Plusieurs lieux correspondent — choisissez-en un :",
+		"candidatePrompt": "Appuyez sur un chiffre pour choisir un lieu, ou retour arrière pour annuler",
+	},
+}
+
+// UI looks up a UI string by key in lang, falling back to English for an
+// unknown language or key.
+func UI(lang, key string) string {
+	if word, ok := uiStrings[lang][key]; ok {
+		return word
+	}
+	return uiStrings[DefaultLang][key]
+}
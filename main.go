@@ -2,26 +2,132 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	lipgloss "github.com/charmbracelet/lipgloss"
+
+	"github.com/rsvagle/GoWeatherApp/cache"
+	"github.com/rsvagle/GoWeatherApp/i18n"
+	"github.com/rsvagle/GoWeatherApp/render"
+	"github.com/rsvagle/GoWeatherApp/weather"
 )
 
+// Weather, Location, and DailyWeather are aliased from the weather
+// package so the render package and the CLI output modes can share them
+// without importing package main.
+type Weather = weather.Weather
+type Location = weather.Location
+type DailyWeather = weather.DailyWeather
+
+// defaultLang is used when no --lang flag or config entry is set.
+const defaultLang = i18n.DefaultLang
+
+// number of days of forecast requested from whichever backend is active
+const forecastDays = 7
+
+// how often the background refresh tick re-fetches the current location
+const refreshInterval = 15 * time.Minute
+
+// cacheEnabled is flipped off by --no-cache; read by searchPlaces since
+// it isn't routed through a WeatherBackend
+var cacheEnabled = true
+
 // lipgloss styles
 var titleStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#00ff41"))
-var rainStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#add8e6"))
-var sunnyStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#f9d71c"))
-var cloudStyle3 = lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")).Background(lipgloss.Color("#ffffff"))
-var cloudStyle2 = lipgloss.NewStyle().Foreground(lipgloss.Color("#fffffff"))
+var precipLowStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#335577"))
+var precipMedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#4499dd"))
+var precipHighStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#66ccff"))
+var messageStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff5555"))
+
+// viewMode selects which panel viewModel.View() renders
+type viewMode int
+
+const (
+	dailyViewMode viewMode = iota
+	hourlyViewMode
+)
+
+// toggle flips between the daily grid and the hourly sparkline view
+func (v viewMode) toggle() viewMode {
+	if v == dailyViewMode {
+		return hourlyViewMode
+	}
+	return dailyViewMode
+}
 
 func main() {
-	p := tea.NewProgram(initialModel())
+	backendFlag := flag.String("backend", "", "weather backend to use (open-meteo, openweathermap, met.no, worldweatheronline)")
+	noCacheFlag := flag.Bool("no-cache", false, "disable the on-disk response cache")
+	unitsFlag := flag.String("units", "", "unit system to use: metric, imperial, or si")
+	langFlag := flag.String("lang", "", "ISO language code for weather descriptions and prompts (en, de, es, fr)")
+	formatFlag := flag.String("format", "tui", "output format: tui, oneline, json, or ansi")
+	flag.Parse()
+
+	cacheEnabled = !*noCacheFlag
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Println("Error:", err)
+		cfg = map[string]string{}
+	}
+
+	backendName := *backendFlag
+	if backendName == "" {
+		backendName = cfg["backend"]
+	}
+	if backendName == "" {
+		backendName = defaultBackend
+	}
+
+	backend, err := getBackend(backendName)
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+	if err := backend.Configure(cfg); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(1)
+	}
+
+	if cacheEnabled {
+		backend = newCachingBackend(backend, cache.WeatherTTL)
+	}
+
+	unitSystemName := *unitsFlag
+	if unitSystemName == "" {
+		unitSystemName = cfg["units"]
+	}
+	if unitSystemName == "" {
+		unitSystemName = defaultUnitSystem
+	}
+	units, ok := unitSystems[unitSystemName]
+	if !ok {
+		fmt.Println("Error: unknown unit system", unitSystemName)
+		os.Exit(1)
+	}
+
+	lang := *langFlag
+	if lang == "" {
+		lang = cfg["lang"]
+	}
+	if lang == "" {
+		lang = defaultLang
+	}
+
+	if *formatFlag != "tui" {
+		runNonInteractive(*formatFlag, backend, units, lang)
+		return
+	}
+
+	p := tea.NewProgram(initialModel(backend, units, lang))
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("There's been an error: %v", err)
 		os.Exit(1)
@@ -29,16 +135,32 @@ func main() {
 }
 
 // initialize the viewModel
-func initialModel() viewModel {
+func initialModel(backend WeatherBackend, units Units, lang string) viewModel {
 	startingLocation := getLocationData()
 
 	// Get the weather from the location
-	var weather Weather = getWeatherForLocation(startingLocation.Lat, startingLocation.Lon)
+	weather, err := backend.Fetch(startingLocation.Lat, startingLocation.Lon, forecastDays, units)
+	if err != nil {
+		fmt.Println("Error:", err)
+	}
 
-	// put the full weather response into DailyWeather structs
+	return viewModel{
+		backend:      backend,
+		location:     startingLocation,
+		dailyWeather: toDailyWeather(weather),
+		weather:      weather,
+		units:        units,
+		lang:         lang,
+		input:        "",
+		message:      "",
+	}
+}
+
+// toDailyWeather flattens a Weather response's parallel Daily slices into
+// a []DailyWeather, one entry per forecast day.
+func toDailyWeather(weather Weather) []DailyWeather {
 	var dailyWeatherArray []DailyWeather
 
-	// Iterate through the weather data and display it
 	for i := 0; i < len(weather.Daily.Time); i++ {
 		dailyWeather := DailyWeather{
 			Time:           weather.Daily.Time[i],
@@ -49,18 +171,23 @@ func initialModel() viewModel {
 		dailyWeatherArray = append(dailyWeatherArray, dailyWeather)
 	}
 
-	return viewModel{
-		location:     startingLocation,
-		dailyWeather: dailyWeatherArray,
-		weather:      weather,
-		input:        "",
-		message:      "",
-	}
+	return dailyWeatherArray
 }
 
 // init the bubbletea view
 func (m viewModel) Init() tea.Cmd {
-	return nil
+	return refreshTick()
+}
+
+// refreshMsg tells Update to silently re-fetch the current location's
+// forecast so the UI stays warm without user input.
+type refreshMsg struct{}
+
+// refreshTick schedules the next background refresh.
+func refreshTick() tea.Cmd {
+	return tea.Tick(refreshInterval, func(t time.Time) tea.Msg {
+		return refreshMsg{}
+	})
 }
 
 // updates the view on input
@@ -69,6 +196,10 @@ func (m viewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch msg.Type {
 		case tea.KeyEnter:
+			if len(m.candidates) > 0 {
+				// user must pick a numbered candidate, not press Enter
+				return m, nil
+			}
 			inputLower := strings.ToLower(m.input) // make commands case insensitive
 			if inputLower == "quit" {
 				return m, tea.Quit
@@ -76,14 +207,56 @@ func (m viewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			newModel := m.handleInput(m.input)
 			return newModel, nil
 		case tea.KeyBackspace:
+			if len(m.candidates) > 0 {
+				m.candidates = nil
+				return m, nil
+			}
 			if len(m.input) > 0 {
 				m.input = m.input[:len(m.input)-1]
 			}
 		case tea.KeyCtrlC:
 			return m, tea.Quit
 		default:
+			// while picking from a candidate list, digit keys select a
+			// place and everything else is ignored
+			if len(m.candidates) > 0 {
+				if idx, ok := candidateIndexForKey(msg.String()); ok && idx < len(m.candidates) {
+					return m.selectCandidate(idx), nil
+				}
+				return m, nil
+			}
+
+			// 'h' toggles the hourly view and 'u' cycles unit systems,
+			// as long as the user isn't mid-way through typing a place
+			// name into the input box
+			if msg.String() == "h" && m.input == "" {
+				m.viewMode = m.viewMode.toggle()
+				return m, nil
+			}
+			if msg.String() == "u" && m.input == "" {
+				m.units = nextUnits(m.units)
+				weather, err := m.backend.Fetch(m.location.Lat, m.location.Lon, forecastDays, m.units)
+				if err != nil {
+					m.message = err.Error()
+					return m, nil
+				}
+				m.message = ""
+				m.weather = weather
+				m.dailyWeather = toDailyWeather(weather)
+				return m, nil
+			}
 			m.input += msg.String()
 		}
+	case refreshMsg:
+		weather, err := m.backend.Fetch(m.location.Lat, m.location.Lon, forecastDays, m.units)
+		if err != nil {
+			m.message = err.Error()
+			return m, refreshTick()
+		}
+		m.message = ""
+		m.weather = weather
+		m.dailyWeather = toDailyWeather(weather)
+		return m, refreshTick()
 	}
 	return m, nil
 }
@@ -91,99 +264,195 @@ func (m viewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // view for bubble tea
 // switch the view based on the viewMode
 func (m viewModel) View() string {
-
-	// Print the location and weather data
 	s := "\n"
-	s += titleStyle.Render(fmt.Sprintf("Weather for %s, %s", m.location.City, m.location.Region))
-	s += "\n"
-	s += titleStyle.Render(fmt.Sprintf("Latitude: %s, Longitude: %s", m.location.Lat, m.location.Lon))
-	s += "\n\n"
 
-	// TODO: Need to go line by line where each line contains all daily weather items and their part
-	width := 25
-	dates := m.weather.Daily.Time
-	highs := m.weather.Daily.TemperatureMax
-	lows := m.weather.Daily.TemperatureMin
-	codes := m.weather.Daily.WeatherCode
+	if len(m.candidates) > 0 {
+		s += m.locationHeader()
+		s += m.renderCandidateSelection()
+		return s
+	}
 
-	s += formatDatesLine(dates, width)
-	s += "\n"
-	s += formatSpaceLine(len(dates), width)
-	s += "\n"
-	s += formatVisualWeatherLine(codes, width, 1)
-	s += "\n"
-	s += formatVisualWeatherLine(codes, width, 2)
-	s += "\n"
-	s += formatVisualWeatherLine(codes, width, 3)
+	switch m.viewMode {
+	case hourlyViewMode:
+		s += m.locationHeader()
+		s += m.renderHourlyView()
+	default:
+		s += render.Grid(m.weather, m.location, m.lang)
+		s += "\n"
+	}
+
+	if m.message != "" {
+		s += messageStyle.Render(m.message)
+		s += "\n"
+	}
+
+	// Prompt for more input
 	s += "\n"
-	s += formatSpaceLine(len(dates), width)
+	s += i18n.UI(m.lang, "prompt")
 	s += "\n"
-	s += formatWeatherCodeLine(codes, width)
+	s += fmt.Sprintf("%s", m.input)
+
+	return s
+}
+
+// locationHeader renders the "Weather for City, Region" banner that
+// render.Grid draws for the daily view, for the hourly and
+// candidate-selection views to print themselves.
+func (m viewModel) locationHeader() string {
+	s := titleStyle.Render(fmt.Sprintf("Weather for %s, %s", m.location.City, m.location.Region))
 	s += "\n"
-	s += formatHighsLine(highs, width)
+	s += titleStyle.Render(fmt.Sprintf("Latitude: %s, Longitude: %s", m.location.Lat, m.location.Lon))
+	s += "\n\n"
+	return s
+}
+
+// hourlyTimeLayout matches the local wall-clock timestamps ("timezone=auto")
+// Open-Meteo puts in Weather.Hourly.Time, e.g. "2026-07-26T14:00".
+const hourlyTimeLayout = "2006-01-02T15:04"
+
+// currentHourIndex finds the first entry in times that is not in the past,
+// so the hourly view can start at "now" instead of midnight. It returns 0
+// if times is empty or nothing can be parsed, which just falls back to the
+// old start-of-day behavior.
+func currentHourIndex(times []string) int {
+	now := time.Now()
+	for i, t := range times {
+		parsed, err := time.ParseInLocation(hourlyTimeLayout, t, time.Local)
+		if err != nil {
+			continue
+		}
+		if !parsed.Before(now) {
+			return i
+		}
+	}
+	return 0
+}
+
+// renderHourlyView draws a 24-hour sparkline of temperature with a row
+// of precipitation-probability dots underneath, starting at the first
+// forecast hour that is not already in the past rather than midnight of
+// the forecast's first day.
+func (m viewModel) renderHourlyView() string {
+	hourly := m.weather.Hourly
+
+	start := currentHourIndex(hourly.Time)
+	end := start + 24
+	if end > len(hourly.Time) {
+		end = len(hourly.Time)
+	}
+	width := end - start
+	if width == 0 {
+		return "No hourly data available for this backend\n\n"
+	}
+
+	s := titleStyle.Render(i18n.UI(m.lang, "next24Hours"))
+	s += "\n\n"
+	s += formatHourlySparkline(hourly.Temperature2m[start:end], width)
 	s += "\n"
-	s += formatLowsLine(lows, width)
+	s += formatPrecipitationDots(hourly.PrecipitationProbability[start:end], width)
 	s += "\n\n"
 
-	// Prompt for more input
+	return s
+}
+
+// renderCandidateSelection lists the places a free-text search matched
+// so the user can pick the one they meant.
+func (m viewModel) renderCandidateSelection() string {
+	s := titleStyle.Render(i18n.UI(m.lang, "multipleMatches"))
+	s += "\n\n"
+
+	for i, candidate := range m.candidates {
+		label := candidate.Name
+		if candidate.Admin1 != "" {
+			label += ", " + candidate.Admin1
+		}
+		if candidate.Country != "" {
+			label += ", " + candidate.Country
+		}
+		s += fmt.Sprintf("%d) %s\n", keyForCandidateIndex(i), label)
+	}
+
 	s += "\n"
-	s += "Enter a city and state (e.g., Los Angeles, CA) to get weather or type 'quit' to exit: \n"
-	s += fmt.Sprintf("%s", m.input)
+	s += i18n.UI(m.lang, "candidatePrompt") + "\n"
 
 	return s
 }
 
-func (m viewModel) handleInput(input string) viewModel {
-	// Get location from the input
+// keyForCandidateIndex and candidateIndexForKey convert between a
+// candidate's position in the list and the digit key that selects it.
+// Up to 10 candidates are shown, keyed 1-9 then 0 for the 10th.
+func keyForCandidateIndex(idx int) int {
+	if idx == 9 {
+		return 0
+	}
+	return idx + 1
+}
 
-	// Split the input into city and state
-	parts := strings.Split(input, ",")
-	if len(parts) < 2 {
-		m.message = "Please enter both a city and a state (e.g., Los Angeles, CA)"
+func candidateIndexForKey(key string) (int, bool) {
+	n, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, false
 	}
+	if n == 0 {
+		return 9, true
+	}
+	return n - 1, true
+}
 
-	city := strings.TrimSpace(parts[0])
-	state := strings.TrimSpace(parts[1])
+// handleInput looks up free-text place search results for input. When
+// there's more than one match the user is shown a numbered list to pick
+// from; selectCandidate finishes the job once they do.
+func (m viewModel) handleInput(input string) viewModel {
+	query := strings.TrimSpace(input)
+	m.input = ""
+	if query == "" {
+		return m
+	}
 
-	// Get the latitude and longitude from the city and state
-	lat, lon := getLatLonFromCityState(city, state)
+	candidates, err := searchPlaces(query)
+	if err != nil {
+		m.message = err.Error()
+		return m
+	}
 
-	// Now get weather data for lat/lon
-	location := Location{
-		City:   city,
-		Region: state,
-		Lat:    lat,
-		Lon:    lon,
+	if len(candidates) == 0 {
+		m.message = fmt.Sprintf("No places found matching %q", query)
+		return m
 	}
 
-	// If we get back 0,0 for lat and lon just put unknown
-	if lat == "0.0" && lon == "0.0" {
-		location.City = "Unknown"
-		location.Region = "Unknown"
+	m.message = ""
+	m.candidates = candidates
+	if len(candidates) == 1 {
+		return m.selectCandidate(0)
 	}
 
-	// Get the weather from the location
-	var weather Weather = getWeatherForLocation(location.Lat, location.Lon)
+	return m
+}
 
-	// put the full weather response into DailyWeather structs
-	var dailyWeatherArray []DailyWeather
+// selectCandidate fetches weather for the chosen place and returns to
+// the normal weather view.
+func (m viewModel) selectCandidate(idx int) viewModel {
+	candidate := m.candidates[idx]
 
-	// Iterate through the weather data and display it
-	for i := 0; i < len(weather.Daily.Time); i++ {
-		dailyWeather := DailyWeather{
-			Time:           weather.Daily.Time[i],
-			WeatherCode:    weather.Daily.WeatherCode[i],
-			TemperatureMax: weather.Daily.TemperatureMax[i],
-			TemperatureMin: weather.Daily.TemperatureMin[i],
-		}
-		dailyWeatherArray = append(dailyWeatherArray, dailyWeather)
+	location := Location{
+		City:    candidate.Name,
+		Region:  candidate.Admin1,
+		Country: candidate.Country,
+		Lat:     fmt.Sprintf("%f", candidate.Latitude),
+		Lon:     fmt.Sprintf("%f", candidate.Longitude),
+	}
+
+	weather, err := m.backend.Fetch(location.Lat, location.Lon, forecastDays, m.units)
+	if err != nil {
+		m.message = err.Error()
+	} else {
+		m.message = ""
 	}
 
-	// Update the model
 	m.location = location
-	m.dailyWeather = dailyWeatherArray
+	m.dailyWeather = toDailyWeather(weather)
 	m.weather = weather
-	m.input = ""
+	m.candidates = nil
 
 	return m
 }
@@ -235,336 +504,77 @@ func getLocationData() Location {
 	return ipBasedLocation
 }
 
-func getWeatherForLocation(lat string, lon string) Weather {
-	dailyMetrics := "weather_code,temperature_2m_max,temperature_2m_min"
-	units := "fahrenheit"
-
-	baseURL := "https://api.open-meteo.com/v1/forecast"
-
-	// build the query string
-	queryString := fmt.Sprintf("?latitude=%s&longitude=%s&daily=%s&temperature_unit=%s",
-		lat,
-		lon,
-		dailyMetrics,
-		units,
-	)
-
-	// construct the full string
-	fullURL := baseURL + queryString
-
-	// make the http request for weather data
-	res, err := http.Get(fullURL)
-	if err != nil {
-		fmt.Println("Error:", err)
-	}
-	defer res.Body.Close()
-
-	// read the body in
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		fmt.Println("Error:", err)
-	}
-
-	// put the json response into my object
-	var weather Weather
-	err = json.Unmarshal(body, &weather)
-	if err != nil {
-		fmt.Println("Error:", err)
-	}
-
-	return weather
-}
-
-func getLatLonFromCityState(city, state string) (string, string) {
-	// Placeholder default location in case of failure
-	defaultLat := "0.0"
-	defaultLon := "0.0"
-
-	// LocationIQ API key and endpoint
-	apiKey := "pk.cd63b67671438fd13619f5b4afadcb8c"
-	url := fmt.Sprintf("https://us1.locationiq.com/v1/search.php?key=%s&q=%s,%s&format=json", apiKey, city, state)
-
-	// Make the HTTP GET request
-	resp, err := http.Get(url)
-	if err != nil {
-		fmt.Println("Error making request to LocationIQ:", err)
-		return defaultLat, defaultLon
-	}
-	defer resp.Body.Close()
-
-	// Read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		fmt.Println("Error reading response body:", err)
-		return defaultLat, defaultLon
-	}
-
-	// Parse the JSON response
-	var locationData []LocationIQResponse
-	err = json.Unmarshal(body, &locationData)
-	if err != nil {
-		fmt.Println("Error parsing JSON response:", err)
-		return defaultLat, defaultLon
-	}
-
-	// Check if any results were returned
-	if len(locationData) > 0 {
-		lat := locationData[0].Lat
-		lon := locationData[0].Lon
-		return lat, lon
-	}
-
-	// If no data, return default lat/lon
-	return defaultLat, defaultLon
-}
-
-// Function to get weather description based on code
-func getWeatherDescriptionFromCode(code int) string {
-	switch code {
-	case 0:
-		return "Sunny"
-	case 1, 2, 3:
-		return "Cloudy"
-	case 45, 48:
-		return "Fog"
-	case 51, 53, 55, 56, 57, 61, 63, 65, 66, 67, 80, 81, 82:
-		return "Rain"
-	case 71, 73, 75, 77, 85, 86:
-		return "Snow"
-	case 95, 96, 99:
-		return "Thunderstorm"
-	default:
-		return "Unknown weather code"
-	}
-}
-
-func getASCIILine1ForWeather(code int) (string, int) {
-	switch code {
-	case 0:
-		return sunnyStyle.Render("\\ | /"), len("\\ | /")
-	case 1, 2, 3:
-		return cloudStyle2.Render("  ____"), len("    __")
-	case 45, 48:
-		return "o o o", len("o o o")
-	case 51, 53, 55, 56, 57, 61, 63, 65, 66, 67, 80, 81, 82:
-		return rainStyle.Render("/ / /"), len("/ / /")
-	case 71, 73, 75, 77, 85, 86:
-		return "* * * *", len("* * * *")
-	case 95, 96, 99:
-		return "(   ( )", len("(   ( )")
-	default:
-		return "Unknown weather code", 1
-	}
-}
-
-func getASCIILine2ForWeather(code int) (string, int) {
-	switch code {
-	case 0:
-		return sunnyStyle.Render("-- O --"), len("-- O --")
-	case 1, 2, 3:
-		return cloudStyle2.Render("_(    )"), len("   (  )")
-	case 45, 48:
-		return "o o o o", len("o o o o")
-	case 51, 53, 55, 56, 57, 61, 63, 65, 66, 67, 80, 81, 82:
-		return rainStyle.Render("/ / / /"), len("/ / / /")
-	case 71, 73, 75, 77, 85, 86:
-		return " * * *", len(" * * *")
-	case 95, 96, 99:
-		return "(   (   )", len("(   (   )")
-	default:
-		return "Unknown weather code", 1
-	}
-}
-
-func getASCIILine3ForWeather(code int) (string, int) {
-	switch code {
-	case 0:
-		return sunnyStyle.Render("/ | \\"), len("/ | \\")
-	case 1, 2, 3:
-		return "(____)___)", len("(____)___)")
-	case 45, 48:
-		return "o o o", len("o o o")
-	case 51, 53, 55, 56, 57, 61, 63, 65, 66, 67, 80, 81, 82:
-		return rainStyle.Render("/ /  /"), len("/ /  /")
-	case 71, 73, 75, 77, 85, 86:
-		return "* * * *", len("* * * *")
-	case 95, 96, 99:
-		return "/ / / /", len("/ / / /")
-	default:
-		return "Unknown weather code", 1
-	}
-}
-
-func formatDate(dateStr string) string {
-	// Parse the input string as a date
-	layout := "2006-01-02"
-	date, err := time.Parse(layout, dateStr)
-	if err != nil {
+// formatHourlySparkline renders up to width values as a horizontal
+// sparkline using Unicode block characters, scaled linearly between the
+// min and max of the visible window.
+func formatHourlySparkline(values []float64, width int) string {
+	if len(values) == 0 {
 		return ""
 	}
-
-	// Format the date like "Sunday October 13"
-	formattedDate := date.Format("Monday January 2")
-	return formattedDate
-}
-
-// Helper function to format each date chunk to have a fixed width
-func formatDatesChunk(text string, width int) string {
-	date := formatDate(text)
-
-	// Calculate the padding needed to center the text
-	padding := (width - len(date)) / 2
-	return fmt.Sprintf("%s%s%s", strings.Repeat(" ", padding), date, strings.Repeat(" ", width-len(date)-padding))
-}
-
-// Create a formatted line of text with equal width chunks
-func formatDatesLine(dates []string, width int) string {
-	chunks := make([]string, len(dates))
-	for i, date := range dates {
-		chunks[i] = formatDatesChunk(date, width)
+	if len(values) > width {
+		values = values[:width]
 	}
-	return strings.Join(chunks, " | ")
-}
 
-// Helper function to format each high temp chunk to have a fixed width
-func formatHighsChunk(high float64, width int) string {
-	// convert to string and add
-	text := fmt.Sprintf("High %.0f", high)
+	blocks := []rune("▁▂▃▄▅▆▇█")
 
-	// Calculate the padding needed to center the text
-	padding := (width - len(text)) / 2
-	return fmt.Sprintf("%s%s%s", strings.Repeat(" ", padding), text, strings.Repeat(" ", width-len(text)-padding))
-}
-
-// Create a formatted line of text with equal width chunks
-func formatHighsLine(highs []float64, width int) string {
-	chunks := make([]string, len(highs))
-	for i, high := range highs {
-		chunks[i] = formatHighsChunk(high, width)
-	}
-	return strings.Join(chunks, " | ")
-}
-
-// Helper function to format each high temp chunk to have a fixed width
-func formatLowsChunk(low float64, width int) string {
-	// convert to string and add
-	text := fmt.Sprintf("Low %.0f", low)
-
-	// Calculate the padding needed to center the text
-	padding := (width - len(text)) / 2
-	return fmt.Sprintf("%s%s%s", strings.Repeat(" ", padding), text, strings.Repeat(" ", width-len(text)-padding))
-}
-
-// Create a formatted line of text with equal width chunks
-func formatLowsLine(lows []float64, width int) string {
-	chunks := make([]string, len(lows))
-	for i, low := range lows {
-		chunks[i] = formatLowsChunk(low, width)
+	min, max := values[0], values[0]
+	for _, v := range values {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
 	}
-	return strings.Join(chunks, " | ")
-}
 
-// Create a formatted line of space with equal width chunks
-func formatSpaceLine(numOfChunks int, width int) string {
-	chunks := make([]string, numOfChunks)
-	for i := range chunks {
-		chunks[i] = strings.Repeat(" ", width)
+	var sb strings.Builder
+	spread := max - min
+	for _, v := range values {
+		level := 0
+		if spread > 0 {
+			level = int((v - min) / spread * float64(len(blocks)-1))
+		}
+		sb.WriteRune(blocks[level])
 	}
-	return strings.Join(chunks, " | ")
-}
-
-// Helper function to format each weather code chunk to have a fixed width
-func formatWeatherCodeChunk(code int, width int) string {
-	weatherCode := getWeatherDescriptionFromCode(code)
 
-	// Calculate the padding needed to center the text
-	padding := (width - len(weatherCode)) / 2
-	return fmt.Sprintf("%s%s%s", strings.Repeat(" ", padding), weatherCode, strings.Repeat(" ", width-len(weatherCode)-padding))
+	return sb.String()
 }
 
-// Create a formatted line of text with equal width chunks
-func formatWeatherCodeLine(codes []int, width int) string {
-	chunks := make([]string, len(codes))
-	for i, code := range codes {
-		chunks[i] = formatWeatherCodeChunk(code, width)
+// formatPrecipitationDots renders up to width precipitation-probability
+// percentages as a row of dots, colored by intensity.
+func formatPrecipitationDots(probs []int, width int) string {
+	if len(probs) == 0 {
+		return ""
 	}
-	return strings.Join(chunks, " | ")
-}
-
-// Create a formatted line of text with equal width chunks
-func formatVisualWeatherLine(codes []int, width int, lineNumber int) string {
-	chunks := make([]string, len(codes))
-	for i, code := range codes {
-		chunks[i] = formatASCIICodeChunk(code, width, lineNumber)
+	if len(probs) > width {
+		probs = probs[:width]
 	}
-	return strings.Join(chunks, " | ")
-}
 
-// Helper function to format each date chunk to have a fixed width
-func formatASCIICodeChunk(code int, width int, lineNumber int) string {
-	var weatherASCII string
-	var weatherASCIIWidth int
-
-	switch lineNumber {
-	case 1:
-		weatherASCII, weatherASCIIWidth = getASCIILine1ForWeather(code)
-	case 2:
-		weatherASCII, weatherASCIIWidth = getASCIILine2ForWeather(code)
-	case 3:
-		weatherASCII, weatherASCIIWidth = getASCIILine3ForWeather(code)
-	default:
-		weatherASCII, weatherASCIIWidth = "Unkown", 9
+	var sb strings.Builder
+	for _, p := range probs {
+		style := precipLowStyle
+		switch {
+		case p >= 70:
+			style = precipHighStyle
+		case p >= 30:
+			style = precipMedStyle
+		}
+		sb.WriteString(style.Render("●"))
 	}
 
-	// Calculate the padding needed to center the text
-	padding := (width - weatherASCIIWidth) / 2
-
-	return strings.Repeat(" ", padding) + weatherASCII + strings.Repeat(" ", width-weatherASCIIWidth-padding)
-}
-
-// struct to match the API json response
-type Location struct {
-	IP      string `json:"ip"`
-	City    string `json:"city"`
-	Region  string `json:"region"`
-	Country string `json:"country"`
-	LatLon  string `json:"loc"`
-
-	// Separate lat and lon after getting them from the API req
-	Lat string
-	Lon string
-}
-
-// API response for LocationIQ
-type LocationIQResponse struct {
-	Lat string `json:"lat"`
-	Lon string `json:"lon"`
-}
-
-// struct to match the API json response
-type Weather struct {
-	Latitude  float32 `json:"latitude"`
-	Longitude float32 `json:"longitude"`
-	Daily     struct {
-		WeatherCode    []int     `json:"weather_code"`
-		Time           []string  `json:"time"`
-		TemperatureMax []float64 `json:"temperature_2m_max"`
-		TemperatureMin []float64 `json:"temperature_2m_min"`
-	} `json:"daily"`
-}
-
-type DailyWeather struct {
-	WeatherCode    int
-	Time           string
-	TemperatureMax float64
-	TemperatureMin float64
+	return sb.String()
 }
 
 // struct for the view
 type viewModel struct {
+	backend      WeatherBackend
 	input        string
 	message      string
 	location     Location
 	dailyWeather []DailyWeather
 	weather      Weather
+	viewMode     viewMode
+	units        Units
+	lang         string
+	candidates   []PlaceCandidate
 }
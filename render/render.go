@@ -0,0 +1,246 @@
+// Package render draws the multi-day weather grid: dates, ASCII weather
+// icons, and highs/lows in fixed-width columns. It's shared by the TUI's
+// daily view and the CLI's non-interactive --format=ansi output, so the
+// two never drift apart.
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	lipgloss "github.com/charmbracelet/lipgloss"
+
+	"github.com/rsvagle/GoWeatherApp/i18n"
+	"github.com/rsvagle/GoWeatherApp/weather"
+)
+
+// lipgloss styles for the grid. titleStyle is the same style package main
+// uses for its own view headers, so titles look alike in every view.
+var titleStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#00ff41"))
+var rainStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#add8e6"))
+var sunnyStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#f9d71c"))
+var cloudStyle2 = lipgloss.NewStyle().Foreground(lipgloss.Color("#fffffff"))
+
+const gridWidth = 25
+
+// Grid renders w's daily forecast for loc as the fixed-width grid of
+// dates, ASCII icons, and highs/lows the TUI's daily view and the CLI's
+// ansi format both display. lang selects which language labels and
+// weather descriptions are rendered in.
+func Grid(w weather.Weather, loc weather.Location, lang string) string {
+	dates := w.Daily.Time
+	highs := w.Daily.TemperatureMax
+	lows := w.Daily.TemperatureMin
+	codes := w.Daily.WeatherCode
+
+	s := titleStyle.Render(fmt.Sprintf("Weather for %s, %s", loc.City, loc.Region))
+	s += "\n"
+	s += titleStyle.Render(fmt.Sprintf("Latitude: %s, Longitude: %s", loc.Lat, loc.Lon))
+	s += "\n\n"
+
+	s += formatDatesLine(dates, gridWidth)
+	s += "\n"
+	s += formatSpaceLine(len(dates), gridWidth)
+	s += "\n"
+	s += formatVisualWeatherLine(codes, gridWidth, 1)
+	s += "\n"
+	s += formatVisualWeatherLine(codes, gridWidth, 2)
+	s += "\n"
+	s += formatVisualWeatherLine(codes, gridWidth, 3)
+	s += "\n"
+	s += formatSpaceLine(len(dates), gridWidth)
+	s += "\n"
+	s += formatWeatherCodeLine(codes, gridWidth, lang)
+	s += "\n"
+	s += formatHighsLine(highs, gridWidth, lang)
+	s += "\n"
+	s += formatLowsLine(lows, gridWidth, lang)
+	s += "\n"
+
+	return s
+}
+
+func getASCIILine1ForWeather(code int) (string, int) {
+	switch code {
+	case 0:
+		return sunnyStyle.Render("\\ | /"), len("\\ | /")
+	case 1, 2, 3:
+		return cloudStyle2.Render("  ____"), len("    __")
+	case 45, 48:
+		return "o o o", len("o o o")
+	case 51, 53, 55, 56, 57, 61, 63, 65, 66, 67, 80, 81, 82:
+		return rainStyle.Render("/ / /"), len("/ / /")
+	case 71, 73, 75, 77, 85, 86:
+		return "* * * *", len("* * * *")
+	case 95, 96, 99:
+		return "(   ( )", len("(   ( )")
+	default:
+		return "Unknown weather code", 1
+	}
+}
+
+func getASCIILine2ForWeather(code int) (string, int) {
+	switch code {
+	case 0:
+		return sunnyStyle.Render("-- O --"), len("-- O --")
+	case 1, 2, 3:
+		return cloudStyle2.Render("_(    )"), len("   (  )")
+	case 45, 48:
+		return "o o o o", len("o o o o")
+	case 51, 53, 55, 56, 57, 61, 63, 65, 66, 67, 80, 81, 82:
+		return rainStyle.Render("/ / / /"), len("/ / / /")
+	case 71, 73, 75, 77, 85, 86:
+		return " * * *", len(" * * *")
+	case 95, 96, 99:
+		return "(   (   )", len("(   (   )")
+	default:
+		return "Unknown weather code", 1
+	}
+}
+
+func getASCIILine3ForWeather(code int) (string, int) {
+	switch code {
+	case 0:
+		return sunnyStyle.Render("/ | \\"), len("/ | \\")
+	case 1, 2, 3:
+		return "(____)___)", len("(____)___)")
+	case 45, 48:
+		return "o o o", len("o o o")
+	case 51, 53, 55, 56, 57, 61, 63, 65, 66, 67, 80, 81, 82:
+		return rainStyle.Render("/ /  /"), len("/ /  /")
+	case 71, 73, 75, 77, 85, 86:
+		return "* * * *", len("* * * *")
+	case 95, 96, 99:
+		return "/ / / /", len("/ / / /")
+	default:
+		return "Unknown weather code", 1
+	}
+}
+
+func formatDate(dateStr string) string {
+	// Parse the input string as a date
+	layout := "2006-01-02"
+	date, err := time.Parse(layout, dateStr)
+	if err != nil {
+		return ""
+	}
+
+	// Format the date like "Sunday October 13"
+	formattedDate := date.Format("Monday January 2")
+	return formattedDate
+}
+
+// Helper function to format each date chunk to have a fixed width
+func formatDatesChunk(text string, width int) string {
+	date := formatDate(text)
+
+	// Calculate the padding needed to center the text
+	padding := (width - len(date)) / 2
+	return fmt.Sprintf("%s%s%s", strings.Repeat(" ", padding), date, strings.Repeat(" ", width-len(date)-padding))
+}
+
+// Create a formatted line of text with equal width chunks
+func formatDatesLine(dates []string, width int) string {
+	chunks := make([]string, len(dates))
+	for i, date := range dates {
+		chunks[i] = formatDatesChunk(date, width)
+	}
+	return strings.Join(chunks, " | ")
+}
+
+// Helper function to format each high temp chunk to have a fixed width
+func formatHighsChunk(high float64, width int, lang string) string {
+	// convert to string and add
+	text := fmt.Sprintf("%s %.0f", i18n.UI(lang, "high"), high)
+
+	// Calculate the padding needed to center the text
+	padding := (width - len(text)) / 2
+	return fmt.Sprintf("%s%s%s", strings.Repeat(" ", padding), text, strings.Repeat(" ", width-len(text)-padding))
+}
+
+// Create a formatted line of text with equal width chunks
+func formatHighsLine(highs []float64, width int, lang string) string {
+	chunks := make([]string, len(highs))
+	for i, high := range highs {
+		chunks[i] = formatHighsChunk(high, width, lang)
+	}
+	return strings.Join(chunks, " | ")
+}
+
+// Helper function to format each low temp chunk to have a fixed width
+func formatLowsChunk(low float64, width int, lang string) string {
+	// convert to string and add
+	text := fmt.Sprintf("%s %.0f", i18n.UI(lang, "low"), low)
+
+	// Calculate the padding needed to center the text
+	padding := (width - len(text)) / 2
+	return fmt.Sprintf("%s%s%s", strings.Repeat(" ", padding), text, strings.Repeat(" ", width-len(text)-padding))
+}
+
+// Create a formatted line of text with equal width chunks
+func formatLowsLine(lows []float64, width int, lang string) string {
+	chunks := make([]string, len(lows))
+	for i, low := range lows {
+		chunks[i] = formatLowsChunk(low, width, lang)
+	}
+	return strings.Join(chunks, " | ")
+}
+
+// Create a formatted line of space with equal width chunks
+func formatSpaceLine(numOfChunks int, width int) string {
+	chunks := make([]string, numOfChunks)
+	for i := range chunks {
+		chunks[i] = strings.Repeat(" ", width)
+	}
+	return strings.Join(chunks, " | ")
+}
+
+// Helper function to format each weather code chunk to have a fixed width
+func formatWeatherCodeChunk(code int, width int, lang string) string {
+	weatherCode := i18n.Description(code, lang)
+
+	// Calculate the padding needed to center the text
+	padding := (width - len(weatherCode)) / 2
+	return fmt.Sprintf("%s%s%s", strings.Repeat(" ", padding), weatherCode, strings.Repeat(" ", width-len(weatherCode)-padding))
+}
+
+// Create a formatted line of text with equal width chunks
+func formatWeatherCodeLine(codes []int, width int, lang string) string {
+	chunks := make([]string, len(codes))
+	for i, code := range codes {
+		chunks[i] = formatWeatherCodeChunk(code, width, lang)
+	}
+	return strings.Join(chunks, " | ")
+}
+
+// Create a formatted line of text with equal width chunks
+func formatVisualWeatherLine(codes []int, width int, lineNumber int) string {
+	chunks := make([]string, len(codes))
+	for i, code := range codes {
+		chunks[i] = formatASCIICodeChunk(code, width, lineNumber)
+	}
+	return strings.Join(chunks, " | ")
+}
+
+// Helper function to format each ASCII weather icon chunk to have a fixed width
+func formatASCIICodeChunk(code int, width int, lineNumber int) string {
+	var weatherASCII string
+	var weatherASCIIWidth int
+
+	switch lineNumber {
+	case 1:
+		weatherASCII, weatherASCIIWidth = getASCIILine1ForWeather(code)
+	case 2:
+		weatherASCII, weatherASCIIWidth = getASCIILine2ForWeather(code)
+	case 3:
+		weatherASCII, weatherASCIIWidth = getASCIILine3ForWeather(code)
+	default:
+		weatherASCII, weatherASCIIWidth = "Unkown", 9
+	}
+
+	// Calculate the padding needed to center the text
+	padding := (width - weatherASCIIWidth) / 2
+
+	return strings.Repeat(" ", padding) + weatherASCII + strings.Repeat(" ", width-weatherASCIIWidth-padding)
+}
@@ -0,0 +1,34 @@
+package main
+
+// Units bundles the measurement units a weather request should be made
+// in. Open-Meteo accepts each of these as a separate query parameter.
+type Units struct {
+	Name          string // imperial, metric, or si
+	Temperature   string // open-meteo temperature_unit
+	WindSpeed     string // open-meteo wind_speed_unit
+	Precipitation string // open-meteo precipitation_unit
+}
+
+// unitSystems are the unit systems selectable via --units or the 'u' key.
+var unitSystems = map[string]Units{
+	"imperial": {Name: "imperial", Temperature: "fahrenheit", WindSpeed: "mph", Precipitation: "inch"},
+	"metric":   {Name: "metric", Temperature: "celsius", WindSpeed: "kmh", Precipitation: "mm"},
+	"si":       {Name: "si", Temperature: "celsius", WindSpeed: "ms", Precipitation: "mm"},
+}
+
+// unitSystemOrder fixes the cycling order for the 'u' keybinding.
+var unitSystemOrder = []string{"imperial", "metric", "si"}
+
+// defaultUnitSystem matches the fahrenheit units the app always used
+// before unit systems were configurable.
+const defaultUnitSystem = "imperial"
+
+// nextUnits returns the unit system that follows current in the cycle.
+func nextUnits(current Units) Units {
+	for i, name := range unitSystemOrder {
+		if name == current.Name {
+			return unitSystems[unitSystemOrder[(i+1)%len(unitSystemOrder)]]
+		}
+	}
+	return unitSystems[unitSystemOrder[0]]
+}
@@ -0,0 +1,48 @@
+// Package weather holds the data types shared by the weather backends,
+// the renderer, and the CLI output modes, so none of them need to import
+// package main.
+package weather
+
+// Location describes where a forecast is for. It doubles as the shape of
+// ipinfo.io's response, which is how getLocationData populates it by
+// default.
+type Location struct {
+	IP      string `json:"ip"`
+	City    string `json:"city"`
+	Region  string `json:"region"`
+	Country string `json:"country"`
+	LatLon  string `json:"loc"`
+
+	// Separate lat and lon after getting them from the API req
+	Lat string
+	Lon string
+}
+
+// Weather is the normalized forecast shape every WeatherBackend returns.
+// Its JSON tags match Open-Meteo's response so that backend can decode
+// directly into it; the other backends populate it field by field.
+type Weather struct {
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+	Daily     struct {
+		WeatherCode    []int     `json:"weather_code"`
+		Time           []string  `json:"time"`
+		TemperatureMax []float64 `json:"temperature_2m_max"`
+		TemperatureMin []float64 `json:"temperature_2m_min"`
+	} `json:"daily"`
+	Hourly struct {
+		Time                     []string  `json:"time"`
+		Temperature2m            []float64 `json:"temperature_2m"`
+		PrecipitationProbability []int     `json:"precipitation_probability"`
+		WeatherCode              []int     `json:"weather_code"`
+	} `json:"hourly"`
+}
+
+// DailyWeather is one day of Weather.Daily, flattened out of its parallel
+// slices for easier use in the view layer.
+type DailyWeather struct {
+	WeatherCode    int
+	Time           string
+	TemperatureMax float64
+	TemperatureMin float64
+}